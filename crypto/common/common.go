@@ -0,0 +1,42 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package common
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// GetRandomInt returns a random integer in [0, max) drawn from crypto/rand.
+func GetRandomInt(max *big.Int) *big.Int {
+	r, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Contains returns true if slice contains value.
+func Contains(slice []int, value int) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
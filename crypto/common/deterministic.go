@@ -0,0 +1,51 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package common
+
+import (
+	"io"
+	"math/big"
+)
+
+// GetDeterministicInt draws a uniformly random integer in (0, bound) from reader by
+// reading bound.BitLen() bits at a time and rejection-sampling any value that is either
+// zero or >= bound, the same technique GetRandomInt uses against crypto/rand. Zero is
+// excluded because this is meant for nonces (e.g. an RFC 6979 k value), for which zero
+// is never a valid output. Passing a reader returned by groups.DeterministicRandReader
+// makes the result reproducible for a given seed. bound must be greater than 1, or no
+// value in (0, bound) exists and GetDeterministicInt panics.
+func GetDeterministicInt(reader io.Reader, bound *big.Int) *big.Int {
+	if bound.Cmp(big.NewInt(1)) <= 0 {
+		panic("common: GetDeterministicInt: bound must be greater than 1")
+	}
+
+	byteLen := (bound.BitLen() + 7) / 8
+	buf := make([]byte, byteLen)
+
+	for {
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			panic(err) // the deterministic reader never returns an error
+		}
+
+		n := new(big.Int).SetBytes(buf)
+		n.Rsh(n, uint(byteLen*8-bound.BitLen()))
+		if n.Sign() != 0 && n.Cmp(bound) < 0 {
+			return n
+		}
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xlab-si/emmy/crypto/common"
+	"github.com/xlab-si/emmy/crypto/groups"
+)
+
+func TestGetDeterministicInt(t *testing.T) {
+	bound := big.NewInt(1000000007)
+	seed := []byte("seed")
+	msg := []byte("msg")
+
+	a := common.GetDeterministicInt(groups.DeterministicRandReader(seed, msg), bound)
+	b := common.GetDeterministicInt(groups.DeterministicRandReader(seed, msg), bound)
+
+	if a.Cmp(b) != 0 {
+		t.Fatalf("two draws from the same seed differ: %v != %v", a, b)
+	}
+	if a.Sign() <= 0 || a.Cmp(bound) >= 0 {
+		t.Fatalf("result %v not in (0, %v)", a, bound)
+	}
+}
+
+func TestGetDeterministicInt_PanicsOnTooSmallBound(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected GetDeterministicInt to panic for bound <= 1")
+		}
+	}()
+
+	common.GetDeterministicInt(groups.DeterministicRandReader([]byte("seed"), []byte("msg")), big.NewInt(1))
+}
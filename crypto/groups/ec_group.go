@@ -0,0 +1,98 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+
+	"github.com/xlab-si/emmy/crypto/common"
+)
+
+// ECGroup is an elliptic-curve group: a cyclic subgroup of the points on Curve, generated
+// by (Gx, Gy) and of order N. It implements Group so that protocols parameterized over Group
+// can run over an elliptic curve instead of a multiplicative SchnorrGroup.
+type ECGroup struct {
+	Curve  elliptic.Curve
+	Gx, Gy *big.Int // generator
+	N      *big.Int // order of the generator
+}
+
+// NewECGroup returns the named elliptic-curve group. Supported curve names are "P224",
+// "P256", "P384" and "P521" (the NIST curves provided by crypto/elliptic).
+func NewECGroup(curveName string) (*ECGroup, error) {
+	var curve elliptic.Curve
+	switch curveName {
+	case "P224":
+		curve = elliptic.P224()
+	case "P256":
+		curve = elliptic.P256()
+	case "P384":
+		curve = elliptic.P384()
+	case "P521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("elliptic curve %s is not supported", curveName)
+	}
+
+	params := curve.Params()
+	return &ECGroup{
+		Curve: curve,
+		Gx:    params.Gx,
+		Gy:    params.Gy,
+		N:     params.N,
+	}, nil
+}
+
+// Mul computes x + y on the curve (the group operation of an elliptic-curve group is point
+// addition; the method is named Mul to match the Group interface).
+func (group *ECGroup) Mul(x, y *GroupElement) *GroupElement {
+	rx, ry := group.Curve.Add(x.X, x.Y, y.X, y.Y)
+	return NewECGroupElement(rx, ry)
+}
+
+// Exp computes exponent * base (scalar multiplication of the point base by exponent).
+func (group *ECGroup) Exp(base *GroupElement, exponent *big.Int) *GroupElement {
+	e := new(big.Int).Mod(exponent, group.N)
+	rx, ry := group.Curve.ScalarMult(base.X, base.Y, e.Bytes())
+	return NewECGroupElement(rx, ry)
+}
+
+// Inv computes the inverse of x, i.e. the point -x such that x + (-x) is the point at infinity.
+func (group *ECGroup) Inv(x *GroupElement) *GroupElement {
+	negY := new(big.Int).Neg(x.Y)
+	negY.Mod(negY, group.Curve.Params().P)
+	return NewECGroupElement(new(big.Int).Set(x.X), negY)
+}
+
+// GetRandomElement returns a random element of the group, i.e. a random multiple of the generator.
+func (group *ECGroup) GetRandomElement() *GroupElement {
+	r := common.GetRandomInt(group.N)
+	return group.Exp(NewECGroupElement(group.Gx, group.Gy), r)
+}
+
+// IsElementInGroup returns true if x lies on the curve.
+func (group *ECGroup) IsElementInGroup(x *GroupElement) bool {
+	return group.Curve.IsOnCurve(x.X, x.Y)
+}
+
+// Order returns the order of the group's generator.
+func (group *ECGroup) Order() *big.Int {
+	return group.N
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import "math/big"
+
+// GroupElement represents an element of a Group. For multiplicative (Schnorr) groups
+// only X is set and it holds the element's value modulo the group's P. For elliptic-curve
+// groups X and Y hold the affine coordinates of a curve point.
+type GroupElement struct {
+	X *big.Int
+	Y *big.Int // nil for multiplicative groups
+}
+
+// NewGroupElement wraps a multiplicative group element (a *big.Int) into a GroupElement.
+func NewGroupElement(x *big.Int) *GroupElement {
+	return &GroupElement{X: x}
+}
+
+// NewECGroupElement wraps the affine coordinates of an elliptic-curve point into a GroupElement.
+func NewECGroupElement(x, y *big.Int) *GroupElement {
+	return &GroupElement{X: x, Y: y}
+}
+
+// Group abstracts over group backends (multiplicative Schnorr groups, elliptic-curve groups,
+// ...) behind a common set of operations, so that a protocol written against Group instead of
+// a concrete backend could run over either without changing its math. Elements are passed
+// around as *GroupElement so a single protocol implementation can consume either a
+// modular-arithmetic backend or an elliptic-curve one. No protocol in this tree (CL
+// credentials, Schnorr ZKPs, commitments) has been re-parameterized over Group yet; SchnorrGroup
+// and ECGroup are both usable through it via SchnorrGroup.AsGroup() and ECGroup directly.
+type Group interface {
+	// Mul computes x * y in the group.
+	Mul(x, y *GroupElement) *GroupElement
+	// Exp computes base^exponent in the group.
+	Exp(base *GroupElement, exponent *big.Int) *GroupElement
+	// Inv computes the inverse of x in the group.
+	Inv(x *GroupElement) *GroupElement
+	// GetRandomElement returns a random element of the group.
+	GetRandomElement() *GroupElement
+	// IsElementInGroup returns true if x is an element of the group.
+	IsElementInGroup(x *GroupElement) bool
+	// Order returns the order of the group (the order of its generator).
+	Order() *big.Int
+}
+
+// Order returns the order Q of the SchnorrGroup's generator.
+func (group *SchnorrGroup) Order() *big.Int {
+	return group.Q
+}
+
+// schnorrGroupAdapter adapts SchnorrGroup's *big.Int-based API to the *GroupElement-based
+// Group interface, leaving SchnorrGroup's own methods (and everything that already calls them)
+// untouched.
+type schnorrGroupAdapter struct {
+	*SchnorrGroup
+}
+
+// AsGroup returns group as a Group, so it can be used anywhere a Group is expected
+// interchangeably with an elliptic-curve backend such as ECGroup.
+func (group *SchnorrGroup) AsGroup() Group {
+	return &schnorrGroupAdapter{group}
+}
+
+func (a *schnorrGroupAdapter) Mul(x, y *GroupElement) *GroupElement {
+	return NewGroupElement(a.SchnorrGroup.Mul(x.X, y.X))
+}
+
+func (a *schnorrGroupAdapter) Exp(base *GroupElement, exponent *big.Int) *GroupElement {
+	return NewGroupElement(a.SchnorrGroup.Exp(base.X, exponent))
+}
+
+func (a *schnorrGroupAdapter) Inv(x *GroupElement) *GroupElement {
+	return NewGroupElement(a.SchnorrGroup.Inv(x.X))
+}
+
+func (a *schnorrGroupAdapter) GetRandomElement() *GroupElement {
+	return NewGroupElement(a.SchnorrGroup.GetRandomElement())
+}
+
+func (a *schnorrGroupAdapter) IsElementInGroup(x *GroupElement) bool {
+	return a.SchnorrGroup.IsElementInGroup(x.X)
+}
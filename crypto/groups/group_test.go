@@ -0,0 +1,64 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// groupFactories enumerates the Group backends that every test in this file runs against.
+func groupFactories(t *testing.T) map[string]Group {
+	schnorr, err := NewSchnorrGroup(160)
+	if err != nil {
+		t.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+	ec, err := NewECGroup("P256")
+	if err != nil {
+		t.Fatalf("error generating ECGroup: %v", err)
+	}
+
+	return map[string]Group{
+		"SchnorrGroup": schnorr.AsGroup(),
+		"ECGroup":      ec,
+	}
+}
+
+func TestGroup_ExpAndMul(t *testing.T) {
+	for name, group := range groupFactories(t) {
+		t.Run(name, func(t *testing.T) {
+			a := group.GetRandomElement()
+			b := group.GetRandomElement()
+
+			assert.True(t, group.IsElementInGroup(a))
+			assert.True(t, group.IsElementInGroup(b))
+
+			// a^2 computed via Exp should equal a*a computed via Mul.
+			squared := group.Exp(a, big.NewInt(2))
+			multiplied := group.Mul(a, a)
+			assert.Equal(t, squared, multiplied)
+
+			// a * a^-1 should be the identity, i.e. a^0.
+			aInv := group.Inv(a)
+			identity := group.Exp(a, big.NewInt(0))
+			assert.Equal(t, identity, group.Mul(a, aInv))
+		})
+	}
+}
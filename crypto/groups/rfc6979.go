@@ -0,0 +1,69 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"io"
+)
+
+// hmacDRBG is an io.Reader that streams pseudorandom bytes produced by the HMAC-DRBG
+// construction described in RFC 6979 ("K"/"V" update), seeded once at construction time.
+type hmacDRBG struct {
+	k, v []byte
+}
+
+// DeterministicRandReader returns an io.Reader producing the same stream of bytes for the
+// same (seed, msg) pair, following the RFC 6979 T/V/K construction built on HMAC-SHA256.
+// This is useful for reproducible test vectors and for "verifier-unpredictable" nonces in
+// offline protocols, and as defense-in-depth should crypto/rand ever be broken or misused.
+func DeterministicRandReader(seed, msg []byte) io.Reader {
+	v := make([]byte, sha256.Size)
+	for i := range v {
+		v[i] = 0x01
+	}
+	k := make([]byte, sha256.Size)
+
+	k = hmacSum(k, v, []byte{0x00}, seed, msg)
+	v = hmacSum(k, v)
+	k = hmacSum(k, v, []byte{0x01}, seed, msg)
+	v = hmacSum(k, v)
+
+	return &hmacDRBG{k: k, v: v}
+}
+
+// Read fills p with bytes derived by repeatedly updating V = HMAC_K(V), as in the RFC 6979
+// generation loop. It never returns an error.
+func (d *hmacDRBG) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		d.v = hmacSum(d.k, d.v)
+		n += copy(p[n:], d.v)
+	}
+	return n, nil
+}
+
+// hmacSum computes HMAC-SHA256(key, concat(parts...)).
+func hmacSum(key []byte, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return mac.Sum(nil)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestDeterministicRandReader_SameSeedSameOutput(t *testing.T) {
+	seed := []byte("master secret")
+	msg := []byte("credential issue nonce")
+
+	a := make([]byte, 64)
+	b := make([]byte, 64)
+
+	if _, err := io.ReadFull(DeterministicRandReader(seed, msg), a); err != nil {
+		t.Fatalf("error reading from reader: %v", err)
+	}
+	if _, err := io.ReadFull(DeterministicRandReader(seed, msg), b); err != nil {
+		t.Fatalf("error reading from reader: %v", err)
+	}
+
+	if !bytes.Equal(a, b) {
+		t.Error("two readers built from the same seed and msg produced different output")
+	}
+}
+
+func TestDeterministicRandReader_DifferentSeedDifferentOutput(t *testing.T) {
+	msg := []byte("credential issue nonce")
+
+	a := make([]byte, 32)
+	b := make([]byte, 32)
+
+	if _, err := io.ReadFull(DeterministicRandReader([]byte("seed one"), msg), a); err != nil {
+		t.Fatalf("error reading from reader: %v", err)
+	}
+	if _, err := io.ReadFull(DeterministicRandReader([]byte("seed two"), msg), b); err != nil {
+		t.Fatalf("error reading from reader: %v", err)
+	}
+
+	if bytes.Equal(a, b) {
+		t.Error("readers built from different seeds produced the same output")
+	}
+}
@@ -34,6 +34,8 @@ type SchnorrGroup struct {
 	P *big.Int // modulus of the group
 	G *big.Int // generator of subgroup
 	Q *big.Int // order of G
+
+	gPrecomp *BasePrecomp // windowed table for G, built once and used by GetRandomElement via ExpPrecomputed
 }
 
 // NewSchnorrGroup generates random SchnorrGroup with generator G and
@@ -58,20 +60,24 @@ func NewSchnorrGroup(qBitLength int) (*SchnorrGroup, error) {
 		return nil, err
 	}
 
-	return &SchnorrGroup{
+	group := &SchnorrGroup{
 		P: params.P,
 		G: params.G,
 		Q: params.Q,
-	}, nil
+	}
+	group.gPrecomp = group.PrecomputeBase(group.G)
+	return group, nil
 
 }
 
 func NewSchnorrGroupFromParams(p, g, q *big.Int) *SchnorrGroup {
-	return &SchnorrGroup{
+	group := &SchnorrGroup{
 		P: p,
 		G: g,
 		Q: q,
 	}
+	group.gPrecomp = group.PrecomputeBase(group.G)
+	return group
 }
 
 // GetRandomElement returns a random element from this group. Note that elements from this group
@@ -79,8 +85,7 @@ func NewSchnorrGroupFromParams(p, g, q *big.Int) *SchnorrGroup {
 // one (random) of these Q elements.
 func (group *SchnorrGroup) GetRandomElement() *big.Int {
 	r := common.GetRandomInt(group.Q)
-	el := group.Exp(group.G, r)
-	return el
+	return group.ExpPrecomputed(group.gPrecomp, r)
 }
 
 // Add computes x + y in SchnorrGroup. This means x + y mod group.P.
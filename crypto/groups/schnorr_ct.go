@@ -0,0 +1,69 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"crypto/subtle"
+	"math/big"
+)
+
+// ExpCT computes base^(secretExp mod group.Q) mod group.P using a fixed-iteration Montgomery
+// ladder; for a base in the order-Q subgroup this equals base^secretExp mod group.P.
+// Unlike Exp (which delegates to big.Int.Exp and runs in time proportional to the bit
+// pattern of the exponent), ExpCT always performs exactly group.Q.BitLen() iterations,
+// each doing the same three modular multiplications, and selects between the two ladder
+// accumulators with a constant-time byte copy (crypto/subtle.ConstantTimeCopy) instead of
+// branching on the secret bit - so the ladder's own control flow does not depend on
+// secretExp. The big.Int multiplications, reductions and byte conversions it is built from
+// are not themselves constant-time. Callers that hold a secret exponent (e.g. a master
+// secret or a blinding factor) should use ExpCT in place of Exp, which is only safe for
+// public exponents.
+func (group *SchnorrGroup) ExpCT(base, secretExp *big.Int) *big.Int {
+	e := new(big.Int).Mod(secretExp, group.Q)
+	b := new(big.Int).Mod(base, group.P)
+
+	r0 := big.NewInt(1)
+	r1 := b
+
+	byteLen := (group.P.BitLen() + 7) / 8
+	bitLen := group.Q.BitLen()
+	for i := bitLen - 1; i >= 0; i-- {
+		mul := new(big.Int).Mod(new(big.Int).Mul(r0, r1), group.P)
+		sq0 := new(big.Int).Mod(new(big.Int).Mul(r0, r0), group.P)
+		sq1 := new(big.Int).Mod(new(big.Int).Mul(r1, r1), group.P)
+
+		// bit == 0: next (r0, r1) = (sq0, mul); bit == 1: next (r0, r1) = (mul, sq1).
+		// Both candidates are computed unconditionally above; only the final selection
+		// below depends on the secret bit, and it does so via a constant-time copy
+		// rather than an if/else on e.Bit(i).
+		mulBytes := mul.FillBytes(make([]byte, byteLen))
+		nextR0 := sq0.FillBytes(make([]byte, byteLen))
+		nextR1 := make([]byte, byteLen)
+		copy(nextR1, mulBytes)
+		sq1Bytes := sq1.FillBytes(make([]byte, byteLen))
+
+		bit := int(e.Bit(i))
+		subtle.ConstantTimeCopy(bit, nextR0, mulBytes)
+		subtle.ConstantTimeCopy(bit, nextR1, sq1Bytes)
+
+		r0 = new(big.Int).SetBytes(nextR0)
+		r1 = new(big.Int).SetBytes(nextR1)
+	}
+
+	return r0
+}
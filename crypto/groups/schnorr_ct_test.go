@@ -0,0 +1,43 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// TestSchnorrGroup_ExpCTMatchesExp checks that the constant-time Montgomery-ladder
+// exponentiation agrees with the fast (variable-time) one for arbitrary exponents.
+func TestSchnorrGroup_ExpCTMatchesExp(t *testing.T) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		t.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+
+	f := func(exp uint32) bool {
+		e := new(big.Int).SetUint64(uint64(exp))
+		base := group.GetRandomElement()
+		return group.Exp(base, e).Cmp(group.ExpCT(base, e)) == 0
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import "math/big"
+
+// baseWindowSize is the window width (in bits) used by PrecomputeBase/ExpPrecomputed.
+const baseWindowSize = 4
+
+// BasePrecomp is a windowed table of base^i mod P for i in [0, 2^baseWindowSize), built once
+// for a base that will be exponentiated many times (e.g. group.G), so that later exponentiations
+// can be computed from table lookups and multiplications alone.
+type BasePrecomp struct {
+	table []*big.Int // table[i] = base^i mod P
+}
+
+// PrecomputeBase builds a windowed table for base for later calls to ExpPrecomputed(pre, exp).
+// ExpPrecomputed reduces every product with a plain big.Int division mod P, unlike the
+// big.Int.Exp behind Exp(base, exp), and is not faster than Exp.
+func (group *SchnorrGroup) PrecomputeBase(base *big.Int) *BasePrecomp {
+	tableSize := 1 << baseWindowSize
+	table := make([]*big.Int, tableSize)
+	table[0] = big.NewInt(1)
+	for i := 1; i < tableSize; i++ {
+		table[i] = group.Mul(table[i-1], base)
+	}
+	return &BasePrecomp{table: table}
+}
+
+// ExpPrecomputed computes base^exponent mod group.P, where pre is a table previously built by
+// PrecomputeBase(base). It processes the exponent baseWindowSize bits at a time: it squares once
+// per exponent bit and multiplies by a table entry at most once per window.
+func (group *SchnorrGroup) ExpPrecomputed(pre *BasePrecomp, exponent *big.Int) *big.Int {
+	e := exponent
+	if exponent.Sign() == -1 {
+		e = new(big.Int).Mod(exponent, group.Q)
+	}
+
+	result := big.NewInt(1)
+	bits := e.BitLen()
+	windows := (bits + baseWindowSize - 1) / baseWindowSize
+	mask := uint(1<<baseWindowSize) - 1
+
+	for w := windows - 1; w >= 0; w-- {
+		for i := 0; i < baseWindowSize; i++ {
+			result = group.Mul(result, result)
+		}
+		digit := uint(new(big.Int).Rsh(e, uint(w*baseWindowSize)).Uint64()) & mask
+		if digit != 0 {
+			result = group.Mul(result, pre.table[digit])
+		}
+	}
+
+	return result
+}
+
+// MultiExp computes the product bases[0]^exps[0] * bases[1]^exps[1] * ... * bases[n-1]^exps[n-1]
+// mod group.P using simultaneous (Straus-style) exponentiation: the squarings of the running
+// result are shared across all terms instead of exponentiating each base separately and then
+// multiplying the results together. bases and exps must be the same length. As with
+// ExpPrecomputed, a negative exponent is reduced mod group.Q before use; for bases in the group
+// this gives the same result as Exp, which inverts base^|exponent| instead.
+func (group *SchnorrGroup) MultiExp(bases, exps []*big.Int) *big.Int {
+	reducedExps := make([]*big.Int, len(exps))
+	maxBits := 0
+	for j, e := range exps {
+		reducedExps[j] = e
+		if e.Sign() == -1 {
+			reducedExps[j] = new(big.Int).Mod(e, group.Q)
+		}
+		if reducedExps[j].BitLen() > maxBits {
+			maxBits = reducedExps[j].BitLen()
+		}
+	}
+
+	result := big.NewInt(1)
+	for i := maxBits - 1; i >= 0; i-- {
+		result = group.Mul(result, result)
+		for j, base := range bases {
+			if reducedExps[j].Bit(i) == 1 {
+				result = group.Mul(result, base)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xlab-si/emmy/crypto/common"
+)
+
+func TestSchnorrGroup_ExpPrecomputedMatchesExp(t *testing.T) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		t.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+
+	pre := group.PrecomputeBase(group.G)
+	for i := 0; i < 20; i++ {
+		exp := common.GetRandomInt(group.Q)
+		want := group.Exp(group.G, exp)
+		got := group.ExpPrecomputed(pre, exp)
+		if want.Cmp(got) != 0 {
+			t.Fatalf("ExpPrecomputed(%v) = %v, want %v", exp, got, want)
+		}
+	}
+}
+
+func TestSchnorrGroup_MultiExpMatchesSequentialExp(t *testing.T) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		t.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+
+	bases := []*big.Int{group.GetRandomElement(), group.GetRandomElement(), group.GetRandomElement()}
+	exps := []*big.Int{
+		common.GetRandomInt(group.Q),
+		common.GetRandomInt(group.Q),
+		common.GetRandomInt(group.Q),
+	}
+
+	want := big.NewInt(1)
+	for i, base := range bases {
+		want = group.Mul(want, group.Exp(base, exps[i]))
+	}
+
+	got := group.MultiExp(bases, exps)
+	if want.Cmp(got) != 0 {
+		t.Fatalf("MultiExp = %v, want %v", got, want)
+	}
+}
+
+func TestSchnorrGroup_MultiExpNegativeExponent(t *testing.T) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		t.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+
+	bases := []*big.Int{group.GetRandomElement(), group.GetRandomElement()}
+	exps := []*big.Int{big.NewInt(-3), common.GetRandomInt(group.Q)}
+
+	want := group.Mul(group.Exp(bases[0], exps[0]), group.Exp(bases[1], exps[1]))
+	got := group.MultiExp(bases, exps)
+	if want.Cmp(got) != 0 {
+		t.Fatalf("MultiExp with a negative exponent = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkSchnorrGroup_Exp(b *testing.B) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		b.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+	exp := common.GetRandomInt(group.Q)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		group.Exp(group.G, exp)
+	}
+}
+
+func BenchmarkSchnorrGroup_ExpPrecomputed(b *testing.B) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		b.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+	pre := group.PrecomputeBase(group.G)
+	exp := common.GetRandomInt(group.Q)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		group.ExpPrecomputed(pre, exp)
+	}
+}
+
+func BenchmarkSchnorrGroup_MultiExp(b *testing.B) {
+	group, err := NewSchnorrGroup(160)
+	if err != nil {
+		b.Fatalf("error generating SchnorrGroup: %v", err)
+	}
+	bases := []*big.Int{group.GetRandomElement(), group.GetRandomElement(), group.GetRandomElement()}
+	exps := []*big.Int{
+		common.GetRandomInt(group.Q),
+		common.GetRandomInt(group.Q),
+		common.GetRandomInt(group.Q),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		group.MultiExp(bases, exps)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/xlab-si/emmy/crypto/common"
+)
+
+// NewSafePrimeSchnorrGroup generates a SchnorrGroup whose modulus P is a safe prime, i.e.
+// P = 2*Q + 1 where Q is itself prime (a Sophie Germain prime). This is needed by protocols
+// that require the quadratic-residue subgroup of Z_P^* (e.g. Pedersen commitments, RSA-style
+// accumulators in the CL scheme) rather than a group derived from dsa.GenerateParameters.
+// pBitLength is the requested bit length of P.
+func NewSafePrimeSchnorrGroup(pBitLength int) (*SchnorrGroup, error) {
+	qBitLength := pBitLength - 1
+
+	for {
+		q, err := rand.Prime(rand.Reader, qBitLength)
+		if err != nil {
+			return nil, err
+		}
+
+		p := new(big.Int).Lsh(q, 1)
+		p.Add(p, big.NewInt(1))
+		if p.BitLen() != pBitLength || !p.ProbablyPrime(20) {
+			continue
+		}
+
+		// The quadratic residues mod P form a subgroup of order Q, so squaring a random
+		// element of Z_P^* yields a generator of that subgroup (unless it squares to 1).
+		for {
+			a := common.GetRandomInt(p)
+			if a.Sign() == 0 {
+				continue
+			}
+			g := new(big.Int).Exp(a, big.NewInt(2), p)
+			if g.Cmp(big.NewInt(1)) == 0 {
+				continue
+			}
+
+			group := NewSchnorrGroupFromParams(p, g, q)
+			if !group.IsElementInGroup(g) {
+				continue
+			}
+			return group, nil
+		}
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2017 XLAB d.o.o.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package groups
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSafePrimeSchnorrGroup(t *testing.T) {
+	group, err := NewSafePrimeSchnorrGroup(64)
+	if err != nil {
+		t.Fatalf("error generating safe-prime SchnorrGroup: %v", err)
+	}
+
+	p := new(big.Int).Sub(group.P, big.NewInt(1))
+	p.Rsh(p, 1)
+	assert.Equal(t, 0, p.Cmp(group.Q), "P should equal 2*Q + 1")
+	assert.True(t, group.IsElementInGroup(group.G))
+}